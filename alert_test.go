@@ -0,0 +1,81 @@
+package sht3x
+
+import (
+	"math"
+	"testing"
+)
+
+// TestPackAlertThresholdKnownValue pins the exact bit layout (7-bit RH in
+// the high bits, 9-bit temperature in the low bits) against a hand-computed
+// value, to catch off-by-one shift regressions.
+func TestPackAlertThresholdKnownValue(t *testing.T) {
+	got := packAlertThreshold(AlertThreshold{TemperatureC: 25, HumidityPct: 50})
+	const want = 64<<9 | 204 // rh=round(50*65535/100)>>9=64, temp=round(70*65535/175)>>7=204
+	if got != want {
+		t.Errorf("packAlertThreshold(25C, 50%%) = 0x%04X, want 0x%04X", got, want)
+	}
+}
+
+// TestAlertThresholdRoundTrip packs and unpacks a range of thresholds,
+// checking the result stays within the quantization error inherent to the
+// 7-bit/9-bit encoding (roughly one LSB: ~0.8% RH, ~0.35degC).
+func TestAlertThresholdRoundTrip(t *testing.T) {
+	v := NewSHT3X()
+	cases := []AlertThreshold{
+		{TemperatureC: -40, HumidityPct: 0},
+		{TemperatureC: 0, HumidityPct: 1},
+		{TemperatureC: 25, HumidityPct: 50},
+		{TemperatureC: 60, HumidityPct: 80},
+		{TemperatureC: 125, HumidityPct: 100},
+	}
+
+	for _, c := range cases {
+		word := packAlertThreshold(c)
+		got := v.unpackAlertThreshold(word)
+
+		if d := math.Abs(float64(got.TemperatureC - c.TemperatureC)); d > 0.5 {
+			t.Errorf("packAlertThreshold/unpackAlertThreshold(%v): temperature round-trip = %v, want within 0.5 of %v",
+				c, got.TemperatureC, c.TemperatureC)
+		}
+		if d := math.Abs(float64(got.HumidityPct - c.HumidityPct)); d > 1 {
+			t.Errorf("packAlertThreshold/unpackAlertThreshold(%v): humidity round-trip = %v, want within 1 of %v",
+				c, got.HumidityPct, c.HumidityPct)
+		}
+	}
+}
+
+// TestPackAlertThresholdClampsOutOfRange checks that out-of-range inputs
+// are clamped rather than silently wrapping during the float-to-uint16
+// conversion.
+func TestPackAlertThresholdClampsOutOfRange(t *testing.T) {
+	low := packAlertThreshold(AlertThreshold{TemperatureC: -1000, HumidityPct: -50})
+	atMin := packAlertThreshold(AlertThreshold{TemperatureC: alertMinTemperatureC, HumidityPct: alertMinHumidityPct})
+	if low != atMin {
+		t.Errorf("packAlertThreshold did not clamp below-range input: got 0x%04X, want 0x%04X", low, atMin)
+	}
+
+	high := packAlertThreshold(AlertThreshold{TemperatureC: 1000, HumidityPct: 500})
+	atMax := packAlertThreshold(AlertThreshold{TemperatureC: alertMaxTemperatureC, HumidityPct: alertMaxHumidityPct})
+	if high != atMax {
+		t.Errorf("packAlertThreshold did not clamp above-range input: got 0x%04X, want 0x%04X", high, atMax)
+	}
+}
+
+// TestWriteAlertLimitsRejectsLimitsThatClampEqual checks that two distinct,
+// monotonic thresholds which clamp to the same packed word are rejected,
+// rather than silently programming HighClear == HighSet on the sensor.
+// WriteAlertLimits must fail validation before it ever touches i2c, so it's
+// safe to call with a nil bus here.
+func TestWriteAlertLimitsRejectsLimitsThatClampEqual(t *testing.T) {
+	v := NewSHT3X()
+	limits := AlertLimits{
+		HighSet:   AlertThreshold{TemperatureC: 500, HumidityPct: 80},
+		HighClear: AlertThreshold{TemperatureC: 131, HumidityPct: 70},
+		LowSet:    AlertThreshold{TemperatureC: -45, HumidityPct: 10},
+		LowClear:  AlertThreshold{TemperatureC: -40, HumidityPct: 20},
+	}
+
+	if err := v.WriteAlertLimits(nil, limits); err == nil {
+		t.Error("WriteAlertLimits accepted limits that clamp to HighClear == HighSet, want an error")
+	}
+}
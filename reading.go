@@ -0,0 +1,164 @@
+package sht3x
+
+import (
+	"math"
+	"time"
+
+	i2c "github.com/d2r2/go-i2c"
+)
+
+// Unit selects the temperature unit reported in a Reading.
+type Unit int
+
+const (
+	UnitCelsius    Unit = iota // degrees Celsius
+	UnitFahrenheit             // degrees Fahrenheit
+	UnitKelvin                 // Kelvin
+)
+
+// String define stringer interface.
+func (v Unit) String() string {
+	switch v {
+	case UnitCelsius:
+		return "Celsius"
+	case UnitFahrenheit:
+		return "Fahrenheit"
+	case UnitKelvin:
+		return "Kelvin"
+	default:
+		return "<unknown>"
+	}
+}
+
+// ReadOptions controls how ReadTemperatureAndHumidity measures and
+// post-processes a sample.
+type ReadOptions struct {
+	// Precision selects the sensor's measurement repeatability. Defaults to
+	// RepeatabilityHigh when zero.
+	Precision MeasureRepeatability
+	// Unit selects the unit Reading.Temperature is reported in.
+	Unit Unit
+	// RoundStep rounds Temperature and Humidity to the nearest multiple of
+	// this value - round(x/step)*step - e.g. 0.25 rounds to the nearest
+	// quarter. Zero disables rounding.
+	RoundStep float64
+	// Smoothing, when greater than zero, keeps a rolling mean over this
+	// many samples on the SHT3X instance and reports the smoothed value
+	// instead of the raw one, denoising periodic-mode consumers.
+	Smoothing int
+	// Derived, when set, additionally computes Reading.DewPoint and
+	// Reading.AbsoluteHumidity.
+	Derived bool
+}
+
+// Reading is one post-processed temperature/humidity sample.
+type Reading struct {
+	Temperature float32
+	Humidity    float32
+	Unit        Unit
+	Timestamp   time.Time
+
+	// DewPoint (Celsius) and AbsoluteHumidity (g/m^3) are only populated
+	// when ReadOptions.Derived is set.
+	DewPoint         *float32
+	AbsoluteHumidity *float32
+}
+
+// ReadTemperatureAndHumidity measures temperature and humidity in "single
+// shot mode" and applies the post-processing described by opts: unit
+// conversion, rounding, rolling-mean smoothing, and derived values.
+func (v *SHT3X) ReadTemperatureAndHumidity(i2c *i2c.I2C, opts ReadOptions) (Reading, error) {
+	precision := opts.Precision
+	if precision == 0 {
+		precision = RepeatabilityHigh
+	}
+
+	ut, urh, err := v.ReadUncompTemperatureAndHumidity(i2c, precision)
+	if err != nil {
+		return Reading{}, err
+	}
+	temp := v.uncompTemperatureToCelsius(ut)
+	rh := v.uncompHumidityToRelativeHumidity(urh)
+
+	if opts.Smoothing > 0 {
+		temp, rh = v.smooth(temp, rh, opts.Smoothing)
+	}
+
+	reading := Reading{
+		Unit:      opts.Unit,
+		Timestamp: time.Now(),
+	}
+	if opts.Derived {
+		dp := dewPointCelsius(temp, rh)
+		ah := absoluteHumidity(temp, rh)
+		reading.DewPoint = &dp
+		reading.AbsoluteHumidity = &ah
+	}
+
+	reading.Temperature = float32(roundToStep(float64(convertTemperature(temp, opts.Unit)), opts.RoundStep))
+	reading.Humidity = float32(roundToStep(float64(rh), opts.RoundStep))
+
+	return reading, nil
+}
+
+// smooth folds a new temperature/humidity sample into the sensor's rolling
+// mean, keeping at most n samples per channel, and returns the new means.
+func (v *SHT3X) smooth(temp, rh float32, n int) (float32, float32) {
+	v.tempSamples = append(v.tempSamples, temp)
+	if len(v.tempSamples) > n {
+		v.tempSamples = v.tempSamples[len(v.tempSamples)-n:]
+	}
+	v.rhSamples = append(v.rhSamples, rh)
+	if len(v.rhSamples) > n {
+		v.rhSamples = v.rhSamples[len(v.rhSamples)-n:]
+	}
+	return mean32(v.tempSamples), mean32(v.rhSamples)
+}
+
+func mean32(xs []float32) float32 {
+	var sum float32
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float32(len(xs))
+}
+
+// roundToStep rounds x to the nearest multiple of step. A zero step
+// disables rounding.
+func roundToStep(x, step float64) float64 {
+	if step == 0 {
+		return x
+	}
+	return math.Round(x/step) * step
+}
+
+// convertTemperature converts a Celsius value to the requested unit.
+func convertTemperature(tempC float32, unit Unit) float32 {
+	switch unit {
+	case UnitFahrenheit:
+		return tempC*9/5 + 32
+	case UnitKelvin:
+		return tempC + 273.15
+	default:
+		return tempC
+	}
+}
+
+// dewPointCelsius computes the dew point (Celsius) from temperature
+// (Celsius) and relative humidity (percent) via the Magnus formula.
+func dewPointCelsius(tempC, rhPct float32) float32 {
+	const a = 17.625
+	const b = 243.04
+	t := float64(tempC)
+	gamma := math.Log(float64(rhPct)/100) + (a*t)/(b+t)
+	return float32(b * gamma / (a - gamma))
+}
+
+// absoluteHumidity computes absolute humidity (g/m^3) from temperature
+// (Celsius) and relative humidity (percent).
+func absoluteHumidity(tempC, rhPct float32) float32 {
+	t := float64(tempC)
+	rh := float64(rhPct)
+	ah := 6.112 * math.Exp((17.67*t)/(t+243.5)) * rh * 2.1674 / (273.15 + t)
+	return float32(ah)
+}
@@ -0,0 +1,67 @@
+package sht3x
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDewPointCelsius pins dewPointCelsius against known reference values
+// for the Magnus formula.
+func TestDewPointCelsius(t *testing.T) {
+	cases := []struct {
+		tempC, rhPct float32
+		want         float32
+	}{
+		{25, 50, 13.86},
+		{0, 100, 0},
+		{30, 80, 26.17},
+		{20, 40, 5.99},
+	}
+
+	for _, c := range cases {
+		got := dewPointCelsius(c.tempC, c.rhPct)
+		if d := math.Abs(float64(got - c.want)); d > 0.02 {
+			t.Errorf("dewPointCelsius(%v, %v) = %v, want %v", c.tempC, c.rhPct, got, c.want)
+		}
+	}
+}
+
+// TestAbsoluteHumidity pins absoluteHumidity against known reference values.
+func TestAbsoluteHumidity(t *testing.T) {
+	cases := []struct {
+		tempC, rhPct float32
+		want         float32
+	}{
+		{25, 50, 11.51},
+		{0, 100, 4.85},
+		{30, 80, 24.28},
+		{20, 40, 6.91},
+	}
+
+	for _, c := range cases {
+		got := absoluteHumidity(c.tempC, c.rhPct)
+		if d := math.Abs(float64(got - c.want)); d > 0.02 {
+			t.Errorf("absoluteHumidity(%v, %v) = %v, want %v", c.tempC, c.rhPct, got, c.want)
+		}
+	}
+}
+
+// TestRoundToStep checks the round(x/step)*step behavior, including the
+// step == 0 "no rounding" case.
+func TestRoundToStep(t *testing.T) {
+	cases := []struct {
+		x, step, want float64
+	}{
+		{21.3, 0, 21.3},
+		{21.37, 0.25, 21.25},
+		{21.38, 0.25, 21.5},
+		{50, 1, 50},
+		{-5.6, 0.5, -5.5},
+	}
+
+	for _, c := range cases {
+		if got := roundToStep(c.x, c.step); got != c.want {
+			t.Errorf("roundToStep(%v, %v) = %v, want %v", c.x, c.step, got, c.want)
+		}
+	}
+}
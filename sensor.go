@@ -0,0 +1,140 @@
+package sht3x
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	i2c "github.com/d2r2/go-i2c"
+)
+
+// Sensor abstracts a single temperature/humidity sensor so that SHT3x
+// devices can be aggregated and polled alongside other sensor types behind
+// one interface.
+type Sensor interface {
+	// Read measures and returns temperature (Celsius) and relative humidity
+	// (percent).
+	Read(ctx context.Context) (temp, rh float32, err error)
+	// Model returns a human-readable sensor model name.
+	Model() string
+	// Address returns the sensor's i2c bus address.
+	Address() uint8
+	// Close releases any resources held by the sensor, such as its i2c bus
+	// handle.
+	Close() error
+}
+
+// Model returns a human-readable sensor model name.
+func (v *SHT3X) Model() string {
+	return "SHT3x"
+}
+
+// Address returns the i2c bus address the sensor was opened at via
+// NewSHT3XAt. It is zero for sensors created with NewSHT3X.
+func (v *SHT3X) Address() uint8 {
+	return v.addr
+}
+
+// Bus returns the i2c bus handle opened by NewSHT3XAt, for callers that
+// still want to use the explicit-handle methods (e.g. StartPeriodicMeasurement)
+// alongside the Sensor interface. It is nil for sensors created with
+// NewSHT3X.
+func (v *SHT3X) Bus() *i2c.I2C {
+	return v.bus
+}
+
+// Close releases the i2c bus handle opened by NewSHT3XAt. It is a no-op for
+// sensors created with NewSHT3X, which never owned one.
+func (v *SHT3X) Close() error {
+	if v.bus == nil {
+		return nil
+	}
+	return v.bus.Close()
+}
+
+// Read implements the Sensor interface: it measures temperature and
+// relative humidity in "single shot mode" over the sensor's own i2c bus
+// handle, at its default repeatability. The sensor must have been created
+// with NewSHT3XAt.
+func (v *SHT3X) Read(ctx context.Context) (float32, float32, error) {
+	if v.bus == nil {
+		return 0, 0, errNoBus
+	}
+	ut, urh, err := v.ReadUncompTemperatureAndHumidityCtx(ctx, v.bus, v.defaultPrecision)
+	if err != nil {
+		return 0, 0, err
+	}
+	temp := v.uncompTemperatureToCelsius(ut)
+	rh := v.uncompHumidityToRelativeHumidity(urh)
+	return temp, rh, nil
+}
+
+var errNoBus = errors.New("sensor has no i2c bus attached; create it with NewSHT3XAt")
+
+// SensorReading is one sensor's result as reported by SensorBank.Read/Poll.
+type SensorReading struct {
+	Sensor      Sensor
+	Temperature float32
+	Humidity    float32
+	Err         error
+}
+
+// sensorBankReadMargin pads the per-sensor read timeout beyond the raw
+// conversion time to leave room for i2c transfer overhead.
+const sensorBankReadMargin = 50 * time.Millisecond
+
+// SensorBank holds multiple Sensor instances, possibly on different i2c
+// buses and addresses, and reads them concurrently.
+type SensorBank struct {
+	sensors []Sensor
+}
+
+// NewSensorBank returns a new SensorBank holding the given sensors.
+func NewSensorBank(sensors ...Sensor) *SensorBank {
+	return &SensorBank{sensors: sensors}
+}
+
+// Read fans out a read to every sensor in the bank concurrently, giving
+// each one a timeout derived from RepeatabilityHigh.GetMeasureTime(). A
+// slow or failing sensor is reported in its own SensorReading.Err rather
+// than aborting the rest of the bank.
+func (b *SensorBank) Read(ctx context.Context) []SensorReading {
+	results := make([]SensorReading, len(b.sensors))
+
+	var wg sync.WaitGroup
+	for i, s := range b.sensors {
+		wg.Add(1)
+		go func(i int, s Sensor) {
+			defer wg.Done()
+			readCtx, cancel := context.WithTimeout(ctx,
+				RepeatabilityHigh.GetMeasureTime()+sensorBankReadMargin)
+			defer cancel()
+
+			temp, rh, err := s.Read(readCtx)
+			results[i] = SensorReading{Sensor: s, Temperature: temp, Humidity: rh, Err: err}
+		}(i, s)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Poll reads the bank at the given interval, until ctx is cancelled. After
+// each round it invokes cb once per sensor, in bank order, once every
+// sensor's read has finished.
+func (b *SensorBank) Poll(ctx context.Context, interval time.Duration, cb func(SensorReading)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, r := range b.Read(ctx) {
+				cb(r)
+			}
+		}
+	}
+}
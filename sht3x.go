@@ -1,8 +1,10 @@
 package sht3x
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
+	"math"
 	"time"
 
 	i2c "github.com/d2r2/go-i2c"
@@ -18,12 +20,50 @@ var (
 	CMD_SINGLE_MEASURE_HIGH       = []byte{0x24, 0x00} // Single Measure of Temp. and Hum.; High precise
 	CMD_SINGLE_MEASURE_MEDIUM     = []byte{0x24, 0x0B} // Single Measure of Temp. and Hum.; Medium precise
 	CMD_SINGLE_MEASURE_LOW        = []byte{0x24, 0x16} // Single Measure of Temp. and Hum.; Low precise
+
+	// Measure values in "periodic acquisition mode", 0.5 measurements per second.
+	CMD_PERIOD_MEASURE_05_HIGH   = []byte{0x20, 0x32}
+	CMD_PERIOD_MEASURE_05_MEDIUM = []byte{0x20, 0x24}
+	CMD_PERIOD_MEASURE_05_LOW    = []byte{0x20, 0x2F}
+	// Measure values in "periodic acquisition mode", 1 measurement per second.
+	CMD_PERIOD_MEASURE_1_HIGH   = []byte{0x21, 0x30}
+	CMD_PERIOD_MEASURE_1_MEDIUM = []byte{0x21, 0x26}
+	CMD_PERIOD_MEASURE_1_LOW    = []byte{0x21, 0x2D}
+	// Measure values in "periodic acquisition mode", 2 measurements per second.
+	CMD_PERIOD_MEASURE_2_HIGH   = []byte{0x22, 0x36}
+	CMD_PERIOD_MEASURE_2_MEDIUM = []byte{0x22, 0x20}
+	CMD_PERIOD_MEASURE_2_LOW    = []byte{0x22, 0x2B}
+	// Measure values in "periodic acquisition mode", 4 measurements per second.
+	CMD_PERIOD_MEASURE_4_HIGH   = []byte{0x23, 0x34}
+	CMD_PERIOD_MEASURE_4_MEDIUM = []byte{0x23, 0x22}
+	CMD_PERIOD_MEASURE_4_LOW    = []byte{0x23, 0x29}
+	// Measure values in "periodic acquisition mode", 10 measurements per second.
+	CMD_PERIOD_MEASURE_10_HIGH   = []byte{0x27, 0x37}
+	CMD_PERIOD_MEASURE_10_MEDIUM = []byte{0x27, 0x21}
+	CMD_PERIOD_MEASURE_10_LOW    = []byte{0x27, 0x2A}
+
 // Other commands.
 	CMD_PERIOD_FETCH = []byte{0xE0, 0x00} // Read data after being measured by periodic acquisition mode command
 	CMD_ART          = []byte{0x2B, 0x32} // Activate "accelerated response time"
 	CMD_BREAK        = []byte{0x30, 0x93} // Interrupt "periodic acqusition mode" and return to "single shot mode"
 	CMD_RESET        = []byte{0x30, 0xA2} // Soft reset command
 
+	// Heater and status register commands.
+	CMD_HEATER_ENABLE  = []byte{0x30, 0x6D} // Enable the on-die heater
+	CMD_HEATER_DISABLE = []byte{0x30, 0x66} // Disable the on-die heater
+	CMD_READ_STATUS    = []byte{0xF3, 0x2D} // Read status register
+	CMD_CLEAR_STATUS   = []byte{0x30, 0x41} // Clear status register
+
+	// Alert mode threshold commands.
+	CMD_ALERT_WRITE_HIGH_SET   = []byte{0x61, 0x1D} // Write alert high-set threshold
+	CMD_ALERT_WRITE_HIGH_CLEAR = []byte{0x61, 0x16} // Write alert high-clear threshold
+	CMD_ALERT_WRITE_LOW_CLEAR  = []byte{0x61, 0x0B} // Write alert low-clear threshold
+	CMD_ALERT_WRITE_LOW_SET    = []byte{0x61, 0x00} // Write alert low-set threshold
+	CMD_ALERT_READ_HIGH_SET    = []byte{0xE1, 0x1F} // Read alert high-set threshold
+	CMD_ALERT_READ_HIGH_CLEAR  = []byte{0xE1, 0x14} // Read alert high-clear threshold
+	CMD_ALERT_READ_LOW_CLEAR   = []byte{0xE1, 0x09} // Read alert low-clear threshold
+	CMD_ALERT_READ_LOW_SET     = []byte{0xE1, 0x02} // Read alert low-set threshold
+
 )
 
 // MeasureRepeatability used to define measure precision.
@@ -66,12 +106,73 @@ func (v MeasureRepeatability) GetMeasureTime() time.Duration {
 	}
 }
 
+const (
+	MPS05  PeriodicMeasure = iota + 1 // 0.5 measurements per second
+	MPS1                              // 1 measurement per second
+	MPS2                              // 2 measurements per second
+	MPS4                              // 4 measurements per second
+	MPS10                             // 10 measurements per second
+	MPSART                            // accelerated response time, fixed 4 Hz
+)
+
+// String define stringer interface.
+func (v PeriodicMeasure) String() string {
+	switch v {
+	case MPS05:
+		return "0.5 measurements per second"
+	case MPS1:
+		return "1 measurement per second"
+	case MPS2:
+		return "2 measurements per second"
+	case MPS4:
+		return "4 measurements per second"
+	case MPS10:
+		return "10 measurements per second"
+	case MPSART:
+		return "4 measurements per second (ART)"
+	default:
+		return "<unknown>"
+	}
+}
+
+// GetWaitTime define the minimum interval between two FetchPeriodicData
+// calls according to specification (1/mps) - implementers should not
+// poll the sensor faster than this.
+func (v PeriodicMeasure) GetWaitTime() time.Duration {
+	switch v {
+	case MPS05:
+		return 2000 * time.Millisecond
+	case MPS1:
+		return 1000 * time.Millisecond
+	case MPS2:
+		return 500 * time.Millisecond
+	case MPS4, MPSART:
+		return 250 * time.Millisecond
+	case MPS10:
+		return 100 * time.Millisecond
+	default:
+		return 0
+	}
+}
+
 // SHT3X is a sensor itself.
 type SHT3X struct {
 	lastStatusReg *uint16
 	lastCmd       []byte
 	lastPeriodic  PeriodicMeasure
 	lastPrecision MeasureRepeatability
+
+	// bus and addr are only set when the sensor was created with
+	// NewSHT3XAt, letting it own its i2c handle and satisfy the Sensor
+	// interface without callers passing an *i2c.I2C on every call.
+	bus              *i2c.I2C
+	addr             uint8
+	defaultPrecision MeasureRepeatability
+
+	// tempSamples and rhSamples back the rolling mean used by
+	// ReadOptions.Smoothing.
+	tempSamples []float32
+	rhSamples   []float32
 }
 
 // NewSHT3X return new sensor instance.
@@ -80,6 +181,18 @@ func NewSHT3X() *SHT3X {
 	return v
 }
 
+// NewSHT3XAt opens the i2c bus line itself and returns a sensor instance
+// bound to it, so callers don't have to construct an *i2c.I2C of their own
+// to use the Sensor interface or SensorBank.
+func NewSHT3XAt(bus int, addr uint8) (*SHT3X, error) {
+	h, err := i2c.NewI2C(addr, bus)
+	if err != nil {
+		return nil, err
+	}
+	v := &SHT3X{bus: h, addr: addr, defaultPrecision: RepeatabilityHigh}
+	return v, nil
+}
+
 
 // readDataWithCRCCheck read block of data which ordinary contain
 // uncompensated temperature and humidity values.
@@ -114,19 +227,32 @@ func (v *SHT3X) readDataWithCRCCheck(i2c *i2c.I2C, blockCount int) ([]uint16, er
 }
 
 // initiateMeasure used to initiate temperature and humidity measurement process.
-func (v *SHT3X) initiateMeasure(i2c *i2c.I2C, cmd []byte,
+func (v *SHT3X) initiateMeasure(ctx context.Context, i2c *i2c.I2C, cmd []byte,
 	precision MeasureRepeatability) error {
 
+	// Single-shot commands are not accepted while periodic acquisition
+	// mode is active - break out of it first.
+	if v.lastPeriodic != 0 {
+		if err := v.StopPeriodicMeasurement(i2c); err != nil {
+			return err
+		}
+	}
+
 	_, err := i2c.WriteBytes(cmd)
 	if err != nil {
 		return err
 	}
 	v.lastCmd = cmd
 
-	// Wait according to conversion time specification
+	// Wait according to conversion time specification, but let the caller
+	// cancel the wait via ctx instead of blocking it on a bare time.Sleep.
 	pause := precision.GetMeasureTime()
-	time.Sleep(pause)
-	return nil
+	select {
+	case <-time.After(pause):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // ReadUncompTemperatureAndHumidity returns uncompensated humidity and
@@ -134,6 +260,15 @@ func (v *SHT3X) initiateMeasure(i2c *i2c.I2C, cmd []byte,
 func (v *SHT3X) ReadUncompTemperatureAndHumidity(i2c *i2c.I2C,
 	precision MeasureRepeatability) (uint16, uint16, error) {
 
+	return v.ReadUncompTemperatureAndHumidityCtx(context.Background(), i2c, precision)
+}
+
+// ReadUncompTemperatureAndHumidityCtx is the context-aware variant of
+// ReadUncompTemperatureAndHumidity, used by Read so that long
+// high-repeatability waits become cancellable.
+func (v *SHT3X) ReadUncompTemperatureAndHumidityCtx(ctx context.Context, i2c *i2c.I2C,
+	precision MeasureRepeatability) (uint16, uint16, error) {
+
 	lg.Debug("Measuring temperature and humidity...")
 	var cmd []byte
 	switch precision {
@@ -144,7 +279,7 @@ func (v *SHT3X) ReadUncompTemperatureAndHumidity(i2c *i2c.I2C,
 	case RepeatabilityHigh:
 		cmd = CMD_SINGLE_MEASURE_HIGH
 	}
-	err := v.initiateMeasure(i2c, cmd, precision)
+	err := v.initiateMeasure(ctx, i2c, cmd, precision)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -156,32 +291,39 @@ func (v *SHT3X) ReadUncompTemperatureAndHumidity(i2c *i2c.I2C,
 	return data[0], data[1], nil
 }
 
-// ReadTemperatureAndRelativeHumidity returns humidity and
-// temperature obtained from sensor in "single shot mode".
+// ReadTemperatureAndRelativeHumidity returns humidity and temperature
+// obtained from sensor in "single shot mode". It's a thin wrapper over
+// ReadTemperatureAndHumidity kept for backward compatibility.
 func (v *SHT3X) ReadTemperatureAndRelativeHumidity(i2c *i2c.I2C,
 	precision MeasureRepeatability) (float32, float32, error) {
 
-	ut, urh, err := v.ReadUncompTemperatureAndHumidity(i2c, precision)
+	reading, err := v.ReadTemperatureAndHumidity(i2c, ReadOptions{
+		Precision: precision,
+		Unit:      UnitCelsius,
+	})
 	if err != nil {
 		return 0, 0, err
 	}
-	lg.Debugf("Temperature and humidity uncompensated = %v, %v", ut, urh)
-	temp := v.uncompTemperatureToCelsius(ut)
-	rh := v.uncompHumidityToRelativeHumidity(urh)
-	return temp, rh, nil
+	// Round independently of opts.RoundStep to preserve the 2-decimal
+	// precision this method always returned.
+	return round32(reading.Temperature, 2), round32(reading.Humidity, 2), nil
 }
 
+// ReadTemperatureAndRelativeHumidityFarenheit is a thin wrapper over
+// ReadTemperatureAndHumidity kept for backward compatibility.
 func (v *SHT3X) ReadTemperatureAndRelativeHumidityFarenheit(i2c *i2c.I2C,
 	precision MeasureRepeatability) (float32, float32, error) {
 
-	ut, urh, err := v.ReadUncompTemperatureAndHumidity(i2c, precision)
+	reading, err := v.ReadTemperatureAndHumidity(i2c, ReadOptions{
+		Precision: precision,
+		Unit:      UnitFahrenheit,
+	})
 	if err != nil {
 		return 0, 0, err
 	}
-	lg.Debugf("Temperature and humidity uncompensated = %v, %v", ut, urh)
-	temp := v.uncompTemperatureToFarenheit(ut)
-	rh := v.uncompHumidityToRelativeHumidity(urh)
-	return temp, rh, nil
+	// Round independently of opts.RoundStep to preserve the 2-decimal
+	// precision this method always returned.
+	return round32(reading.Temperature, 2), round32(reading.Humidity, 2), nil
 }
 
 // Convert uncompensated humidity to relative humidity.
@@ -205,6 +347,149 @@ func (v *SHT3X) uncompTemperatureToFarenheit(ut uint16) float32 {
 	return temp2
 }
 
+// StartPeriodicMeasurement switches the sensor into "periodic acquisition
+// mode", sampling at the given rate and repeatability. Once active, data
+// must be retrieved with FetchPeriodicData; single-shot commands break
+// out of periodic mode automatically.
+func (v *SHT3X) StartPeriodicMeasurement(i2c *i2c.I2C, mps PeriodicMeasure,
+	precision MeasureRepeatability) error {
+
+	lg.Debugf("Starting periodic measurement at %v, %v...", mps, precision)
+	var cmd []byte
+	switch mps {
+	case MPS05:
+		switch precision {
+		case RepeatabilityHigh:
+			cmd = CMD_PERIOD_MEASURE_05_HIGH
+		case RepeatabilityMedium:
+			cmd = CMD_PERIOD_MEASURE_05_MEDIUM
+		case RepeatabilityLow:
+			cmd = CMD_PERIOD_MEASURE_05_LOW
+		}
+	case MPS1:
+		switch precision {
+		case RepeatabilityHigh:
+			cmd = CMD_PERIOD_MEASURE_1_HIGH
+		case RepeatabilityMedium:
+			cmd = CMD_PERIOD_MEASURE_1_MEDIUM
+		case RepeatabilityLow:
+			cmd = CMD_PERIOD_MEASURE_1_LOW
+		}
+	case MPS2:
+		switch precision {
+		case RepeatabilityHigh:
+			cmd = CMD_PERIOD_MEASURE_2_HIGH
+		case RepeatabilityMedium:
+			cmd = CMD_PERIOD_MEASURE_2_MEDIUM
+		case RepeatabilityLow:
+			cmd = CMD_PERIOD_MEASURE_2_LOW
+		}
+	case MPS4:
+		switch precision {
+		case RepeatabilityHigh:
+			cmd = CMD_PERIOD_MEASURE_4_HIGH
+		case RepeatabilityMedium:
+			cmd = CMD_PERIOD_MEASURE_4_MEDIUM
+		case RepeatabilityLow:
+			cmd = CMD_PERIOD_MEASURE_4_LOW
+		}
+	case MPS10:
+		switch precision {
+		case RepeatabilityHigh:
+			cmd = CMD_PERIOD_MEASURE_10_HIGH
+		case RepeatabilityMedium:
+			cmd = CMD_PERIOD_MEASURE_10_MEDIUM
+		case RepeatabilityLow:
+			cmd = CMD_PERIOD_MEASURE_10_LOW
+		}
+	}
+	if cmd == nil {
+		return errors.New(spew.Sprintf("unsupported periodic measurement rate/repeatability: %v, %v",
+			mps, precision))
+	}
+
+	// Switching periodic rate/precision while already in periodic
+	// acquisition mode requires a break first, per specification.
+	if v.lastPeriodic != 0 {
+		if err := v.StopPeriodicMeasurement(i2c); err != nil {
+			return err
+		}
+	}
+
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	v.lastPeriodic = mps
+	v.lastPrecision = precision
+	return nil
+}
+
+// ActivateART switches the sensor into "periodic acquisition mode" with
+// accelerated response time, a fixed 4 Hz sampling rate optimized for
+// fast-changing conditions.
+func (v *SHT3X) ActivateART(i2c *i2c.I2C) error {
+	lg.Debug("Activating accelerated response time mode...")
+
+	// A rate-based periodic mode must be broken out of before switching
+	// into ART, per specification.
+	if v.lastPeriodic != 0 {
+		if err := v.StopPeriodicMeasurement(i2c); err != nil {
+			return err
+		}
+	}
+
+	cmd := CMD_ART
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	v.lastPeriodic = MPSART
+	return nil
+}
+
+// FetchPeriodicData reads the latest temperature/humidity sample collected
+// by the sensor while running in periodic acquisition mode. It fails if
+// neither StartPeriodicMeasurement nor ActivateART has been called. Callers
+// should not poll faster than v.lastPeriodic.GetWaitTime().
+func (v *SHT3X) FetchPeriodicData(i2c *i2c.I2C) (uint16, uint16, error) {
+	if v.lastPeriodic == 0 {
+		return 0, 0, errors.New("periodic acquisition mode is not active")
+	}
+
+	cmd := CMD_PERIOD_FETCH
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return 0, 0, err
+	}
+	v.lastCmd = cmd
+
+	data, err := v.readDataWithCRCCheck(i2c, 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return data[0], data[1], nil
+}
+
+// StopPeriodicMeasurement interrupts "periodic acquisition mode" and
+// returns the sensor to "single shot mode", waiting the datasheet-required
+// time before the next command is accepted.
+func (v *SHT3X) StopPeriodicMeasurement(i2c *i2c.I2C) error {
+	lg.Debug("Stopping periodic measurement...")
+	cmd := CMD_BREAK
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	v.lastPeriodic = 0
+
+	// Minimum time before sensor accepts the next command, per specification.
+	time.Sleep(time.Millisecond)
+	return nil
+}
 
 // Reset reboot a sensor.
 func (v *SHT3X) Reset(i2c *i2c.I2C) error {
@@ -219,3 +504,284 @@ func (v *SHT3X) Reset(i2c *i2c.I2C) error {
 	time.Sleep(time.Microsecond * 1500)
 	return nil
 }
+
+// HeaterEnable turns on the sensor's integrated heater, useful for
+// condensate removal or a plausibility check of the humidity sensor.
+func (v *SHT3X) HeaterEnable(i2c *i2c.I2C) error {
+	lg.Debug("Enabling heater...")
+	cmd := CMD_HEATER_ENABLE
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	return nil
+}
+
+// HeaterDisable turns off the sensor's integrated heater.
+func (v *SHT3X) HeaterDisable(i2c *i2c.I2C) error {
+	lg.Debug("Disabling heater...")
+	cmd := CMD_HEATER_DISABLE
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	return nil
+}
+
+// StatusRegister is a decoded 16-bit sensor status register.
+type StatusRegister uint16
+
+// AlertPending reports whether at least one pending alert is active.
+func (v StatusRegister) AlertPending() bool {
+	return v&(1<<15) != 0
+}
+
+// HeaterOn reports whether the on-die heater is currently switched on.
+func (v StatusRegister) HeaterOn() bool {
+	return v&(1<<13) != 0
+}
+
+// RHTrackingAlert reports whether the humidity tracking alert is active.
+func (v StatusRegister) RHTrackingAlert() bool {
+	return v&(1<<11) != 0
+}
+
+// TTrackingAlert reports whether the temperature tracking alert is active.
+func (v StatusRegister) TTrackingAlert() bool {
+	return v&(1<<10) != 0
+}
+
+// SystemReset reports whether a reset has occurred since the last ClearStatus
+// call, either due to power-up, soft reset, or a supply voltage drop.
+func (v StatusRegister) SystemReset() bool {
+	return v&(1<<4) != 0
+}
+
+// LastCommandOK reports whether the last command was processed successfully.
+func (v StatusRegister) LastCommandOK() bool {
+	return v&(1<<1) == 0
+}
+
+// LastWriteCRCOK reports whether the checksum of the last write transfer
+// was correct.
+func (v StatusRegister) LastWriteCRCOK() bool {
+	return v&(1<<0) == 0
+}
+
+// ReadStatus reads and decodes the sensor status register.
+func (v *SHT3X) ReadStatus(i2c *i2c.I2C) (StatusRegister, error) {
+	lg.Debug("Reading status register...")
+	cmd := CMD_READ_STATUS
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return 0, err
+	}
+	v.lastCmd = cmd
+
+	data, err := v.readDataWithCRCCheck(i2c, 1)
+	if err != nil {
+		return 0, err
+	}
+	status := StatusRegister(data[0])
+	v.lastStatusReg = &data[0]
+
+	lg.Debugf("Status register = 0x%04X: alert pending = %v, heater on = %v, "+
+		"RH tracking alert = %v, T tracking alert = %v, system reset = %v, "+
+		"last command OK = %v, last write CRC OK = %v",
+		uint16(status), status.AlertPending(), status.HeaterOn(),
+		status.RHTrackingAlert(), status.TTrackingAlert(), status.SystemReset(),
+		status.LastCommandOK(), status.LastWriteCRCOK())
+
+	return status, nil
+}
+
+// ClearStatus clears the sensor status register.
+func (v *SHT3X) ClearStatus(i2c *i2c.I2C) error {
+	lg.Debug("Clearing status register...")
+	cmd := CMD_CLEAR_STATUS
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	v.lastStatusReg = nil
+	return nil
+}
+
+// AlertThreshold is a single combined temperature/humidity threshold used
+// to drive the sensor's ALERT pin.
+type AlertThreshold struct {
+	TemperatureC float32
+	HumidityPct  float32
+}
+
+// AlertLimits holds the four programmable thresholds (high-set, high-clear,
+// low-set, low-clear) that make up the sensor's alert mode.
+type AlertLimits struct {
+	HighSet   AlertThreshold
+	HighClear AlertThreshold
+	LowSet    AlertThreshold
+	LowClear  AlertThreshold
+}
+
+// validate checks that thresholds are ordered LowSet < LowClear < HighClear
+// < HighSet on both the temperature and humidity axis, as required by the
+// sensor before alert mode behaves sensibly.
+func (l AlertLimits) validate() error {
+	if !(l.LowSet.TemperatureC < l.LowClear.TemperatureC &&
+		l.LowClear.TemperatureC < l.HighClear.TemperatureC &&
+		l.HighClear.TemperatureC < l.HighSet.TemperatureC) {
+		return errors.New("alert limits: temperature thresholds must satisfy " +
+			"LowSet < LowClear < HighClear < HighSet")
+	}
+	if !(l.LowSet.HumidityPct < l.LowClear.HumidityPct &&
+		l.LowClear.HumidityPct < l.HighClear.HumidityPct &&
+		l.HighClear.HumidityPct < l.HighSet.HumidityPct) {
+		return errors.New("alert limits: humidity thresholds must satisfy " +
+			"LowSet < LowClear < HighClear < HighSet")
+	}
+	return nil
+}
+
+// Sensor-supported range for alert thresholds, per datasheet.
+const (
+	alertMinTemperatureC float32 = -45
+	alertMaxTemperatureC float32 = 130
+	alertMinHumidityPct  float32 = 0
+	alertMaxHumidityPct  float32 = 100
+)
+
+func clampFloat32(x, min, max float32) float32 {
+	if x < min {
+		return min
+	}
+	if x > max {
+		return max
+	}
+	return x
+}
+
+// clampAlertThreshold clamps a threshold's temperature and humidity to the
+// sensor's supported range, so validation runs against the values that will
+// actually be packed rather than the caller's raw, possibly out-of-range
+// ones.
+func clampAlertThreshold(t AlertThreshold) AlertThreshold {
+	return AlertThreshold{
+		TemperatureC: clampFloat32(t.TemperatureC, alertMinTemperatureC, alertMaxTemperatureC),
+		HumidityPct:  clampFloat32(t.HumidityPct, alertMinHumidityPct, alertMaxHumidityPct),
+	}
+}
+
+// packAlertThreshold packs a combined T/RH threshold into the 16-bit word
+// format expected by the sensor: the top 7 bits carry humidity, the low 9
+// bits carry temperature. Inputs are clamped to the sensor's supported
+// range before packing.
+func packAlertThreshold(t AlertThreshold) uint16 {
+	t = clampAlertThreshold(t)
+	rh := uint16(math.Round(float64(t.HumidityPct)*(0x10000-1)/100)) >> 9
+	temp := uint16(math.Round((float64(t.TemperatureC)+45)*(0x10000-1)/175)) >> 7
+	return rh<<9 | temp
+}
+
+// unpackAlertThreshold inverts packAlertThreshold, reusing the same
+// uncompensated-value conversions as the regular measurement path.
+func (v *SHT3X) unpackAlertThreshold(word uint16) AlertThreshold {
+	rh := (word >> 9) << 9
+	temp := (word & 0x1FF) << 7
+	return AlertThreshold{
+		TemperatureC: v.uncompTemperatureToCelsius(temp),
+		HumidityPct:  v.uncompHumidityToRelativeHumidity(rh),
+	}
+}
+
+// writeAlertThreshold packs, CRCs and writes a single threshold word.
+func (v *SHT3X) writeAlertThreshold(i2c *i2c.I2C, cmd []byte, t AlertThreshold) error {
+	word := packAlertThreshold(t)
+	data := []byte{byte(word >> 8), byte(word)}
+	crc := calcCRC_SHT3X(0xFF, data)
+
+	buf := append(append([]byte{}, cmd...), data[0], data[1], crc)
+	_, err := i2c.WriteBytes(buf)
+	if err != nil {
+		return err
+	}
+	v.lastCmd = cmd
+	return nil
+}
+
+// readAlertThreshold reads and unpacks a single threshold word.
+func (v *SHT3X) readAlertThreshold(i2c *i2c.I2C, cmd []byte) (AlertThreshold, error) {
+	_, err := i2c.WriteBytes(cmd)
+	if err != nil {
+		return AlertThreshold{}, err
+	}
+	v.lastCmd = cmd
+
+	data, err := v.readDataWithCRCCheck(i2c, 1)
+	if err != nil {
+		return AlertThreshold{}, err
+	}
+	return v.unpackAlertThreshold(data[0]), nil
+}
+
+// WriteAlertLimits programs all four alert thresholds, driving the sensor's
+// ALERT pin from combined temperature/humidity limits.
+func (v *SHT3X) WriteAlertLimits(i2c *i2c.I2C, limits AlertLimits) error {
+	// Validate against the clamped values that will actually be packed and
+	// written - validating the caller's raw values would let two thresholds
+	// that clamp to the same packed word slip past the ordering check.
+	clamped := AlertLimits{
+		HighSet:   clampAlertThreshold(limits.HighSet),
+		HighClear: clampAlertThreshold(limits.HighClear),
+		LowSet:    clampAlertThreshold(limits.LowSet),
+		LowClear:  clampAlertThreshold(limits.LowClear),
+	}
+	if err := clamped.validate(); err != nil {
+		return err
+	}
+
+	lg.Debug("Writing alert limits...")
+	if err := v.writeAlertThreshold(i2c, CMD_ALERT_WRITE_HIGH_SET, clamped.HighSet); err != nil {
+		return err
+	}
+	if err := v.writeAlertThreshold(i2c, CMD_ALERT_WRITE_HIGH_CLEAR, clamped.HighClear); err != nil {
+		return err
+	}
+	if err := v.writeAlertThreshold(i2c, CMD_ALERT_WRITE_LOW_CLEAR, clamped.LowClear); err != nil {
+		return err
+	}
+	if err := v.writeAlertThreshold(i2c, CMD_ALERT_WRITE_LOW_SET, clamped.LowSet); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadAlertLimits reads back all four programmed alert thresholds.
+func (v *SHT3X) ReadAlertLimits(i2c *i2c.I2C) (AlertLimits, error) {
+	lg.Debug("Reading alert limits...")
+	highSet, err := v.readAlertThreshold(i2c, CMD_ALERT_READ_HIGH_SET)
+	if err != nil {
+		return AlertLimits{}, err
+	}
+	highClear, err := v.readAlertThreshold(i2c, CMD_ALERT_READ_HIGH_CLEAR)
+	if err != nil {
+		return AlertLimits{}, err
+	}
+	lowClear, err := v.readAlertThreshold(i2c, CMD_ALERT_READ_LOW_CLEAR)
+	if err != nil {
+		return AlertLimits{}, err
+	}
+	lowSet, err := v.readAlertThreshold(i2c, CMD_ALERT_READ_LOW_SET)
+	if err != nil {
+		return AlertLimits{}, err
+	}
+
+	return AlertLimits{
+		HighSet:   highSet,
+		HighClear: highClear,
+		LowSet:    lowSet,
+		LowClear:  lowClear,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package sht3x
+
+import "testing"
+
+// TestStatusRegisterAccessors pins each StatusRegister accessor to its
+// documented bit position, so a future bit-shift typo is caught.
+func TestStatusRegisterAccessors(t *testing.T) {
+	cases := []struct {
+		name string
+		bit  uint
+		get  func(StatusRegister) bool
+		// inverted is true for bits that read "ok" when clear (0).
+		inverted bool
+	}{
+		{"AlertPending", 15, StatusRegister.AlertPending, false},
+		{"HeaterOn", 13, StatusRegister.HeaterOn, false},
+		{"RHTrackingAlert", 11, StatusRegister.RHTrackingAlert, false},
+		{"TTrackingAlert", 10, StatusRegister.TTrackingAlert, false},
+		{"SystemReset", 4, StatusRegister.SystemReset, false},
+		{"LastCommandOK", 1, StatusRegister.LastCommandOK, true},
+		{"LastWriteCRCOK", 0, StatusRegister.LastWriteCRCOK, true},
+	}
+
+	for _, c := range cases {
+		set := StatusRegister(1 << c.bit)
+		clear := StatusRegister(^uint16(1 << c.bit))
+
+		wantSet, wantClear := true, false
+		if c.inverted {
+			wantSet, wantClear = false, true
+		}
+
+		if got := c.get(set); got != wantSet {
+			t.Errorf("%s with bit %d set = %v, want %v", c.name, c.bit, got, wantSet)
+		}
+		if got := c.get(clear); got != wantClear {
+			t.Errorf("%s with bit %d clear (all other bits set) = %v, want %v", c.name, c.bit, got, wantClear)
+		}
+	}
+}